@@ -2,9 +2,16 @@ module h3_fingerprint
 
 go 1.25.4
 
-require github.com/quic-go/quic-go v0.58.0
+require (
+	github.com/quic-go/quic-go v0.58.0
+	github.com/refraction-networking/utls v1.8.2
+	github.com/robfig/cron/v3 v3.0.1
+	go.etcd.io/bbolt v1.4.3
+)
 
 require (
+	github.com/andybalholm/brotli v1.0.6 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/quic-go/qpack v0.6.0 // indirect
 	golang.org/x/crypto v0.45.0 // indirect
 	golang.org/x/net v0.47.0 // indirect